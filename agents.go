@@ -0,0 +1,122 @@
+package freshdesk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+type Agent struct {
+	// ID of the agent
+	ID uint64 `json:"id,omitempty"`
+	// Set to true if the agent is occasional, false if full-time
+	Occasional bool `json:"occasional"`
+	// Signature of the agent in HTML format
+	Signature *string `json:"signature,omitempty"`
+	// Group IDs associated with the agent
+	GroupIDs []uint64 `json:"group_ids,omitempty"`
+	// Role IDs assigned to the agent
+	RoleIDs []uint64 `json:"role_ids,omitempty"`
+	// Set to true if the agent accepts tickets
+	Available bool `json:"available"`
+	// Ticket scope of the agent: 1 global, 2 group, 3 restricted
+	TicketScope int `json:"ticket_scope,omitempty"`
+	// Timestamp of the agent's last availability change
+	LastActiveAt *time.Time `json:"last_active_at,omitempty"`
+	// Contact details of the agent (name, email, phone, job title, ...)
+	Contact *Contact `json:"contact,omitempty"`
+	// Agent creation timestamp
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	// Agent updated timestamp
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+}
+
+// AgentListOptions controls pagination and filtering for AgentsClient.List.
+type AgentListOptions struct {
+	// Page number to fetch, starting at 1
+	Page int `url:"page,omitempty"`
+	// Number of agents per page (max 100)
+	PerPage int `url:"per_page,omitempty"`
+	// Filter by email address
+	Email string `url:"email,omitempty"`
+	// Filter by whether the agent is currently accepting tickets
+	State string `url:"state,omitempty"`
+}
+
+type AgentPage = Page[*Agent]
+
+type AgentsClient interface {
+	Update(ctx context.Context, id uint64, a *Agent, opts ...RequestOption) (*Agent, error)
+	View(ctx context.Context, id uint64, opts ...RequestOption) (*Agent, error)
+	List(ctx context.Context, opts *AgentListOptions, reqOpts ...RequestOption) (*AgentPage, error)
+	Delete(ctx context.Context, id uint64, opts ...RequestOption) error
+	// SetAvailability toggles whether an agent is currently accepting new tickets
+	SetAvailability(ctx context.Context, id uint64, available bool, opts ...RequestOption) (*Agent, error)
+}
+
+type agentsClient struct {
+	*client
+}
+
+// Update updates an existing agent
+func (c *agentsClient) Update(ctx context.Context, id uint64, a *Agent, opts ...RequestOption) (*Agent, error) {
+	req, err := c.client.newRequest(ctx, http.MethodPut, fmt.Sprintf("agents/%d", id), a, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	res := new(Agent)
+	err = c.client.do(req, res, http.StatusOK)
+
+	return res, err
+}
+
+// View gets an existing agent by id
+func (c *agentsClient) View(ctx context.Context, id uint64, opts ...RequestOption) (*Agent, error) {
+	req, err := c.client.newRequest(ctx, http.MethodGet, fmt.Sprintf("agents/%d", id), nil, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	res := new(Agent)
+	err = c.client.do(req, res, http.StatusOK)
+
+	return res, err
+}
+
+// List fetches a single page of agents, optionally filtered via opts
+func (c *agentsClient) List(ctx context.Context, opts *AgentListOptions, reqOpts ...RequestOption) (*AgentPage, error) {
+	if opts == nil {
+		opts = &AgentListOptions{}
+	}
+
+	return listPage[*Agent](ctx, c.client, "agents", opts, reqOpts...)
+}
+
+// Delete deletes an existing agent, converting them back into a contact
+func (c *agentsClient) Delete(ctx context.Context, id uint64, opts ...RequestOption) error {
+	req, err := c.client.newRequest(ctx, http.MethodDelete, fmt.Sprintf("agents/%d", id), nil, opts...)
+	if err != nil {
+		return err
+	}
+
+	return c.client.do(req, nil, http.StatusNoContent)
+}
+
+// SetAvailability toggles whether an agent is currently accepting new tickets
+func (c *agentsClient) SetAvailability(ctx context.Context, id uint64, available bool, opts ...RequestOption) (*Agent, error) {
+	type params struct {
+		Available bool `json:"available"`
+	}
+
+	req, err := c.client.newRequest(ctx, http.MethodPut, fmt.Sprintf("agents/%d", id), &params{Available: available}, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	res := new(Agent)
+	err = c.client.do(req, res, http.StatusOK)
+
+	return res, err
+}
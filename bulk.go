@@ -0,0 +1,44 @@
+package freshdesk
+
+import (
+	"context"
+	"net/http"
+)
+
+const defaultBulkChunkSize = 100
+
+// bulkJobResponse is the payload Freshdesk's bulk endpoints respond with:
+// a job id to poll rather than a synchronous result.
+type bulkJobResponse struct {
+	JobID string `json:"job_id"`
+}
+
+// resolveBulkChunkSize applies opts to a scratch requestConfig purely to read
+// back the chunk size, since WithBulkChunkSize is a RequestOption like any
+// other.
+func resolveBulkChunkSize(opts []RequestOption) int {
+	cfg := newRequestConfig()
+	for _, opt := range opts {
+		opt.applyRequest(cfg)
+	}
+	if cfg.bulkChunkSize > 0 {
+		return cfg.bulkChunkSize
+	}
+	return defaultBulkChunkSize
+}
+
+// submitBulkJob POSTs body to endpoint and wraps the resulting job id as a
+// queued Job.
+func (c *client) submitBulkJob(ctx context.Context, endpoint string, body interface{}, opts ...RequestOption) (*Job, error) {
+	req, err := c.newRequest(ctx, http.MethodPost, endpoint, body, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	res := new(bulkJobResponse)
+	if err := c.do(req, res, http.StatusAccepted); err != nil {
+		return nil, err
+	}
+
+	return &Job{ID: res.JobID, Status: JobStatusQueued}, nil
+}
@@ -2,44 +2,107 @@ package freshdesk
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
+	"strconv"
+	"sync/atomic"
 
+	"github.com/google/uuid"
 	"github.com/hashicorp/go-retryablehttp"
+	"golang.org/x/time/rate"
 )
 
 type Client interface {
 	Contacts() ContactsClient
+	Tickets() TicketsClient
+	Companies() CompaniesClient
+	Agents() AgentsClient
+	Groups() GroupsClient
+	Conversations() ConversationsClient
+	Jobs() JobsClient
 	BaseUrl() string
+	// RateLimit returns a snapshot of the Freshdesk rate-limit headers as of
+	// the most recently completed request.
+	RateLimit() RateLimitStatus
 }
 
 type client struct {
-	apiKey   string
-	baseURL  string
-	contacts ContactsClient
+	apiKey        string
+	baseURL       string
+	contacts      ContactsClient
+	tickets       TicketsClient
+	companies     CompaniesClient
+	agents        AgentsClient
+	groups        GroupsClient
+	conversations ConversationsClient
+	jobs          JobsClient
 
-	httpClient *http.Client
+	userAgent   string
+	httpClient  HTTPDoer
+	rateLimiter *rate.Limiter
+	rateLimit   atomic.Pointer[RateLimitStatus]
 }
 
 type Logger interface {
 	retryablehttp.LeveledLogger
 }
 
-func NewClient(subdomain, apiKey string, log Logger) (Client, error) {
-	rc := retryablehttp.NewClient()
+// HTTPDoer is the subset of *http.Client used to issue requests. Satisfied
+// by *http.Client itself, it can also be implemented by a test double so
+// that callers can exercise a Client without hitting the network; see the
+// freshdesktest package.
+type HTTPDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+func NewClient(subdomain, apiKey string, opts ...ClientOption) (Client, error) {
+	cfg := &clientConfig{}
+	for _, opt := range opts {
+		opt.applyClient(cfg)
+	}
+
+	httpClient := cfg.httpClient
+	if httpClient == nil {
+		rc := retryablehttp.NewClient()
+		if cfg.logger != nil {
+			rc.Logger = cfg.logger
+		}
+		if cfg.transport != nil {
+			rc.HTTPClient.Transport = cfg.transport
+		}
 
-	if log != nil {
-		rc.Logger = log
+		threshold := cfg.rateLimitThreshold
+		if threshold == 0 {
+			threshold = defaultRateLimitThreshold
+		}
+		rc.CheckRetry = rateLimitCheckRetry
+		rc.Backoff = newRateLimitBackoff(threshold)
+
+		httpClient = rc.StandardClient()
+	}
+
+	baseURL := cfg.baseURL
+	if baseURL == "" {
+		baseURL = fmt.Sprintf("https://%s.freshdesk.com/api/v2/", subdomain)
 	}
 
 	c := &client{
-		apiKey:     apiKey,
-		baseURL:    fmt.Sprintf("https://%s.freshdesk.com/api/v2/", subdomain),
-		httpClient: rc.StandardClient(),
+		apiKey:      apiKey,
+		baseURL:     baseURL,
+		userAgent:   cfg.userAgent,
+		httpClient:  httpClient,
+		rateLimiter: cfg.rateLimiter,
 	}
 	c.contacts = &contactsClient{c}
+	c.tickets = &ticketsClient{c}
+	c.companies = &companiesClient{c}
+	c.agents = &agentsClient{c}
+	c.groups = &groupsClient{c}
+	c.conversations = &conversationsClient{c}
+	c.jobs = &jobsClient{c}
 	return c, nil
 }
 
@@ -51,7 +114,43 @@ func (c *client) Contacts() ContactsClient {
 	return c.contacts
 }
 
-func (c *client) newRequest(method, endpoint string, body interface{}) (req *Request, err error) {
+func (c *client) Tickets() TicketsClient {
+	return c.tickets
+}
+
+func (c *client) Companies() CompaniesClient {
+	return c.companies
+}
+
+func (c *client) Agents() AgentsClient {
+	return c.agents
+}
+
+func (c *client) Groups() GroupsClient {
+	return c.groups
+}
+
+func (c *client) Conversations() ConversationsClient {
+	return c.conversations
+}
+
+func (c *client) Jobs() JobsClient {
+	return c.jobs
+}
+
+func (c *client) RateLimit() RateLimitStatus {
+	if status := c.rateLimit.Load(); status != nil {
+		return *status
+	}
+	return RateLimitStatus{}
+}
+
+func (c *client) newRequest(ctx context.Context, method, endpoint string, body interface{}, opts ...RequestOption) (req *Request, err error) {
+	cfg := newRequestConfig()
+	for _, opt := range opts {
+		opt.applyRequest(cfg)
+	}
+
 	b := make([]byte, 0)
 	if body != nil {
 		if b, err = json.Marshal(&body); err != nil {
@@ -59,46 +158,103 @@ func (c *client) newRequest(method, endpoint string, body interface{}) (req *Req
 		}
 	}
 
+	baseURL := c.baseURL
+	if cfg.baseURL != "" {
+		baseURL = cfg.baseURL
+	}
+
 	bodyReader := bytes.NewReader(b)
 	var raw *http.Request
-	if raw, err = http.NewRequest(method, c.baseURL+endpoint, bodyReader); err != nil {
+	if raw, err = http.NewRequestWithContext(ctx, method, baseURL+endpoint, bodyReader); err != nil {
 		return
 	}
 
 	raw.SetBasicAuth(c.apiKey, "X")
 	raw.Header.Add("Content-Type", "application/json")
-	return &Request{raw}, nil
+	if c.userAgent != "" {
+		raw.Header.Set("User-Agent", c.userAgent)
+	}
+	for key, values := range cfg.headers {
+		for _, value := range values {
+			raw.Header.Add(key, value)
+		}
+	}
+
+	idempotencyKey := cfg.idempotencyKey
+	if idempotencyKey == "" && cfg.autoIdempotencyKey {
+		idempotencyKey = uuid.NewString()
+	}
+	if idempotencyKey != "" {
+		raw.Header.Set("Idempotency-Key", idempotencyKey)
+		if cfg.idempotencyKeyExpiration > 0 {
+			raw.Header.Set("Idempotency-Key-Expiration", strconv.Itoa(int(cfg.idempotencyKeyExpiration.Seconds())))
+		}
+	}
+
+	return &Request{raw, cfg}, nil
 }
 
 func (c *client) do(req *Request, out interface{}, expectedStatus int) error {
-	raw, err := c.httpClient.Do(req.Request)
+	_, err := c.doWithResponse(req, out, expectedStatus)
+	return err
+}
+
+// doWithResponse behaves like do, but also returns the underlying Response so
+// callers can inspect response headers (e.g. pagination's Link header).
+func (c *client) doWithResponse(req *Request, out interface{}, expectedStatus int) (*Response, error) {
+	httpClient := c.httpClient
+	if req.cfg.httpClient != nil {
+		httpClient = req.cfg.httpClient
+	}
+
+	if req.cfg.timeout > 0 {
+		ctx, cancel := context.WithTimeout(req.Context(), req.cfg.timeout)
+		defer cancel()
+		req.Request = req.Request.WithContext(ctx)
+	}
+
+	if c.rateLimiter != nil {
+		if err := c.rateLimiter.Wait(req.Context()); err != nil {
+			return nil, err
+		}
+	}
+
+	raw, err := httpClient.Do(req.Request)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	defer raw.Body.Close()
 
 	res := &Response{raw}
 
+	status := parseRateLimitStatus(res.Header)
+	c.rateLimit.Store(&status)
+
 	if res.StatusCode != expectedStatus {
-		return NewApiError(
+		apiErr := NewApiError(
 			res.StatusCode,
 			expectedStatus,
 			req.Payload(),
 			res.Payload(),
 		)
+		if res.StatusCode == http.StatusTooManyRequests {
+			apiErr.RateLimit = &status
+		}
+		return res, apiErr
 	}
 	if out != nil {
 		if err = json.NewDecoder(res.Body).Decode(out); err != nil {
-			return err
+			return res, err
 		}
 	}
 
-	return nil
+	return res, nil
 }
 
 type Request struct {
 	*http.Request
+	cfg *requestConfig
 }
 
 func (r *Request) Payload() string {
@@ -0,0 +1,215 @@
+package freshdesk_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/SamuilOlegovich/go-freshdesk"
+	"github.com/SamuilOlegovich/go-freshdesk/freshdesktest"
+)
+
+func newTestClient(t *testing.T, ts *freshdesktest.TestServer) freshdesk.Client {
+	t.Helper()
+	c, err := freshdesk.NewClient("test", "key", ts.ClientOptions()...)
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	return c
+}
+
+func TestTicketsRoundTrip(t *testing.T) {
+	ts := freshdesktest.NewTestServer(t)
+	ts.HandleJSON(http.MethodPost, "/tickets", http.StatusCreated, &freshdesk.Ticket{ID: 1, Subject: "help"})
+	ts.HandleJSON(http.MethodGet, "/tickets/1", http.StatusOK, &freshdesk.Ticket{ID: 1, Subject: "help"})
+
+	c := newTestClient(t, ts)
+
+	created, err := c.Tickets().Create(context.Background(), &freshdesk.Ticket{Subject: "help"})
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if created.ID != 1 {
+		t.Errorf("Create() ID = %d, want 1", created.ID)
+	}
+
+	got, err := c.Tickets().View(context.Background(), created.ID)
+	if err != nil {
+		t.Fatalf("View() error = %v", err)
+	}
+	if got.Subject != "help" {
+		t.Errorf("View() Subject = %q, want %q", got.Subject, "help")
+	}
+}
+
+func TestTicketsUpdateStatusOnlySendsStatus(t *testing.T) {
+	ts := freshdesktest.NewTestServer(t)
+
+	var body string
+	ts.Handle(http.MethodPut, "/tickets/1", func(w http.ResponseWriter, r *http.Request) {
+		body = readBody(t, r)
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(&freshdesk.Ticket{ID: 1, Subject: "help", Status: freshdesk.TicketStatusResolved})
+	})
+
+	c := newTestClient(t, ts)
+
+	updated, err := c.Tickets().UpdateStatus(context.Background(), 1, freshdesk.TicketStatusResolved)
+	if err != nil {
+		t.Fatalf("UpdateStatus() error = %v", err)
+	}
+	if updated.Status != freshdesk.TicketStatusResolved {
+		t.Errorf("UpdateStatus() Status = %v, want %v", updated.Status, freshdesk.TicketStatusResolved)
+	}
+
+	const want = `{"status":4}`
+	if body != want {
+		t.Errorf("UpdateStatus() body = %s, want %s (must not send the Subject field)", body, want)
+	}
+}
+
+func TestCompaniesRoundTrip(t *testing.T) {
+	ts := freshdesktest.NewTestServer(t)
+	ts.HandleJSON(http.MethodPost, "/companies", http.StatusCreated, &freshdesk.Company{ID: 1, Name: "Acme"})
+
+	var mergeBody string
+	ts.Handle(http.MethodPost, "/companies/merge", func(w http.ResponseWriter, r *http.Request) {
+		mergeBody = readBody(t, r)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	c := newTestClient(t, ts)
+
+	created, err := c.Companies().Create(context.Background(), &freshdesk.Company{Name: "Acme"})
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	if err := c.Companies().Merge(context.Background(), created.ID, []uint64{2, 3}); err != nil {
+		t.Fatalf("Merge() error = %v", err)
+	}
+
+	const want = `{"primary_company_id":1,"secondary_company_ids":[2,3]}`
+	if mergeBody != want {
+		t.Errorf("Merge() body = %s, want %s", mergeBody, want)
+	}
+}
+
+func TestAgentsRoundTrip(t *testing.T) {
+	ts := freshdesktest.NewTestServer(t)
+
+	var body string
+	ts.Handle(http.MethodPut, "/agents/1", func(w http.ResponseWriter, r *http.Request) {
+		body = readBody(t, r)
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(&freshdesk.Agent{ID: 1, Available: true})
+	})
+
+	c := newTestClient(t, ts)
+
+	agent, err := c.Agents().SetAvailability(context.Background(), 1, true)
+	if err != nil {
+		t.Fatalf("SetAvailability() error = %v", err)
+	}
+	if !agent.Available {
+		t.Error("SetAvailability() Available = false, want true")
+	}
+
+	const want = `{"available":true}`
+	if body != want {
+		t.Errorf("SetAvailability() body = %s, want %s (must not send the Occasional field)", body, want)
+	}
+}
+
+func TestGroupsRoundTrip(t *testing.T) {
+	ts := freshdesktest.NewTestServer(t)
+	ts.HandleJSON(http.MethodPost, "/groups", http.StatusCreated, &freshdesk.Group{ID: 1, Name: "Support"})
+
+	c := newTestClient(t, ts)
+
+	group, err := c.Groups().Create(context.Background(), &freshdesk.Group{Name: "Support"})
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if group.Name != "Support" {
+		t.Errorf("Create() Name = %q, want %q", group.Name, "Support")
+	}
+}
+
+func TestConversationsRoundTrip(t *testing.T) {
+	ts := freshdesktest.NewTestServer(t)
+	ts.HandleJSON(http.MethodPost, "/tickets/1/reply", http.StatusCreated, &freshdesk.Conversation{ID: 1, TicketID: 1, Body: "hi"})
+
+	c := newTestClient(t, ts)
+
+	reply, err := c.Conversations().CreateReply(context.Background(), 1, "hi")
+	if err != nil {
+		t.Fatalf("CreateReply() error = %v", err)
+	}
+	if reply.Body != "hi" {
+		t.Errorf("CreateReply() Body = %q, want %q", reply.Body, "hi")
+	}
+}
+
+func TestConversationsUpdateLeavesVisibilityUnchangedWhenNil(t *testing.T) {
+	ts := freshdesktest.NewTestServer(t)
+
+	var body string
+	ts.Handle(http.MethodPut, "/conversations/1", func(w http.ResponseWriter, r *http.Request) {
+		body = readBody(t, r)
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(&freshdesk.Conversation{ID: 1, Body: "edited", Private: true})
+	})
+
+	c := newTestClient(t, ts)
+
+	if _, err := c.Conversations().Update(context.Background(), 1, "edited", nil); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+
+	const want = `{"body":"edited"}`
+	if body != want {
+		t.Errorf("Update() body = %s, want %s (must not send the Private field when left unspecified)", body, want)
+	}
+}
+
+// TestFilterContactsRoundTrip exercises the FilterContacts URL-encoding fix
+// end to end: a query containing spaces and quotes must reach the server as
+// a single, correctly encoded query string value rather than breaking the
+// request URI.
+func TestFilterContactsRoundTrip(t *testing.T) {
+	ts := freshdesktest.NewTestServer(t)
+	ts.HandleJSON(http.MethodGet, "/search/contacts", http.StatusOK, &freshdesk.ContactSearchResult{
+		Total:    1,
+		Contacts: []*freshdesk.Contact{{ID: 1}},
+	})
+
+	c := newTestClient(t, ts)
+
+	const queryStr = "language:en AND created_at:>'2020-01-01'"
+	result, err := c.Contacts().FilterContacts(context.Background(), queryStr, nil)
+	if err != nil {
+		t.Fatalf("FilterContacts() error = %v", err)
+	}
+	if len(result.Contacts) != 1 {
+		t.Fatalf("FilterContacts() got %d results, want 1", len(result.Contacts))
+	}
+
+	reqs := ts.Requests()
+	got := reqs[len(reqs)-1].URL.Query().Get("query")
+	want := `"` + queryStr + `"`
+	if got != want {
+		t.Errorf("query param = %q, want %q", got, want)
+	}
+}
+
+func readBody(t *testing.T, r *http.Request) string {
+	t.Helper()
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		t.Fatalf("reading request body: %v", err)
+	}
+	return string(body)
+}
@@ -0,0 +1,137 @@
+package freshdesk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+type Company struct {
+	// ID of the company
+	ID uint64 `json:"id,omitempty"`
+	// Name of the company
+	Name string `json:"name"`
+	// Description of the company
+	Description *string `json:"description,omitempty"`
+	// Domains associated with the company
+	Domains []string `json:"domains,omitempty"`
+	// Any specific note about the company
+	Note *string `json:"note,omitempty"`
+	// Health score of the company
+	HealthScore *string `json:"health_score,omitempty"`
+	// Classification of the company
+	AccountTier *string `json:"account_tier,omitempty"`
+	// Renewal date of the company's account
+	RenewalDate *time.Time `json:"renewal_date,omitempty"`
+	// Industry the company serves in
+	Industry *string `json:"industry,omitempty"`
+	// Key value pair containing the name and value of the custom fields
+	CustomFields map[string]interface{} `json:"custom_fields,omitempty"`
+	// Company creation timestamp
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	// Company updated timestamp
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+}
+
+// CompanyListOptions controls pagination for CompaniesClient.List.
+type CompanyListOptions struct {
+	// Page number to fetch, starting at 1
+	Page int `url:"page,omitempty"`
+	// Number of companies per page (max 100)
+	PerPage int `url:"per_page,omitempty"`
+}
+
+type CompanyPage = Page[*Company]
+
+type CompaniesClient interface {
+	Create(ctx context.Context, co *Company, opts ...RequestOption) (*Company, error)
+	Update(ctx context.Context, id uint64, co *Company, opts ...RequestOption) (*Company, error)
+	View(ctx context.Context, id uint64, opts ...RequestOption) (*Company, error)
+	List(ctx context.Context, opts *CompanyListOptions, reqOpts ...RequestOption) (*CompanyPage, error)
+	Delete(ctx context.Context, id uint64, opts ...RequestOption) error
+	// Merge folds one or more secondary companies into the primary company
+	Merge(ctx context.Context, primaryID uint64, secondaryIDs []uint64, opts ...RequestOption) error
+}
+
+type companiesClient struct {
+	*client
+}
+
+// Create creates a new company
+func (c *companiesClient) Create(ctx context.Context, co *Company, opts ...RequestOption) (*Company, error) {
+	req, err := c.client.newRequest(ctx, http.MethodPost, "companies", co, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	res := new(Company)
+	err = c.client.do(req, res, http.StatusCreated)
+
+	return res, err
+}
+
+// Update updates an existing company
+func (c *companiesClient) Update(ctx context.Context, id uint64, co *Company, opts ...RequestOption) (*Company, error) {
+	req, err := c.client.newRequest(ctx, http.MethodPut, fmt.Sprintf("companies/%d", id), co, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	res := new(Company)
+	err = c.client.do(req, res, http.StatusOK)
+
+	return res, err
+}
+
+// View gets an existing company by id
+func (c *companiesClient) View(ctx context.Context, id uint64, opts ...RequestOption) (*Company, error) {
+	req, err := c.client.newRequest(ctx, http.MethodGet, fmt.Sprintf("companies/%d", id), nil, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	res := new(Company)
+	err = c.client.do(req, res, http.StatusOK)
+
+	return res, err
+}
+
+// List fetches a single page of companies
+func (c *companiesClient) List(ctx context.Context, opts *CompanyListOptions, reqOpts ...RequestOption) (*CompanyPage, error) {
+	if opts == nil {
+		opts = &CompanyListOptions{}
+	}
+
+	return listPage[*Company](ctx, c.client, "companies", opts, reqOpts...)
+}
+
+// Delete deletes an existing company
+func (c *companiesClient) Delete(ctx context.Context, id uint64, opts ...RequestOption) error {
+	req, err := c.client.newRequest(ctx, http.MethodDelete, fmt.Sprintf("companies/%d", id), nil, opts...)
+	if err != nil {
+		return err
+	}
+
+	return c.client.do(req, nil, http.StatusNoContent)
+}
+
+// Merge folds one or more secondary companies into the primary company
+func (c *companiesClient) Merge(ctx context.Context, primaryID uint64, secondaryIDs []uint64, opts ...RequestOption) error {
+	type params struct {
+		// ID of the company that survives the merge
+		PrimaryCompanyID uint64 `json:"primary_company_id"`
+		// IDs of the companies merged into the primary company
+		SecondaryCompanyIDs []uint64 `json:"secondary_company_ids"`
+	}
+
+	req, err := c.client.newRequest(ctx, http.MethodPost, "companies/merge", &params{
+		PrimaryCompanyID:    primaryID,
+		SecondaryCompanyIDs: secondaryIDs,
+	}, opts...)
+	if err != nil {
+		return err
+	}
+
+	return c.client.do(req, nil, http.StatusNoContent)
+}
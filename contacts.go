@@ -1,12 +1,16 @@
 package freshdesk
 
 import (
+	"context"
 	"crypto/md5" //nolint:gosec
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
 	"time"
+
+	"github.com/google/go-querystring/query"
 )
 
 type Contact struct {
@@ -98,18 +102,62 @@ type ContactField struct {
 	Choices map[string]string `json:"choices,omitempty"`
 }
 
+// ListOptions controls pagination and filtering for ContactsClient.List.
+type ListOptions struct {
+	// Page number to fetch, starting at 1
+	Page int `url:"page,omitempty"`
+	// Number of contacts per page (max 100)
+	PerPage int `url:"per_page,omitempty"`
+	// Only return contacts updated at or after this time
+	UpdatedSince *time.Time `url:"updated_since,omitempty"`
+	// Filter by primary email
+	Email string `url:"email,omitempty"`
+	// Filter by phone number
+	Phone string `url:"phone,omitempty"`
+	// Filter by mobile number
+	Mobile string `url:"mobile,omitempty"`
+	// Filter by the ID of the company the contact belongs to
+	CompanyID uint64 `url:"company_id,omitempty"`
+	// Filter by contact state, one of "verified", "unverified" or "blocked"
+	State string `url:"state,omitempty"`
+}
+
+// ContactPage is a single page of contacts along with a cursor to the next page.
+type ContactPage struct {
+	Contacts []*Contact
+	// NextPage is the page number to pass to List to fetch the next page, or
+	// 0 if this was the last page
+	NextPage int
+}
+
+// ContactSearchResult is the result of a FilterContacts query.
+type ContactSearchResult struct {
+	Total    int        `json:"total"`
+	Contacts []*Contact `json:"results"`
+}
+
 type ContactsClient interface {
-	Create(t *Contact) (*Contact, error)
-	Update(id uint64, t *Contact) (*Contact, error)
-	View(id uint64) (*Contact, error)
-	ListAll() ([]*Contact, error)
-	Delete(id uint64) error
-	HardDelete(id uint64, force bool) error
-	Restore(id uint64) error
-	ListAllContactFields() ([]*ContactField, error)
-	SearchContacts(keyword string) (contacts []*Contact, err error)
-	SendInvite(id uint64) error
-	Merge(primaryID uint64, secondaryIDs []uint64, attrs *Contact) error
+	Create(ctx context.Context, t *Contact, opts ...RequestOption) (*Contact, error)
+	Update(ctx context.Context, id uint64, t *Contact, opts ...RequestOption) (*Contact, error)
+	View(ctx context.Context, id uint64, opts ...RequestOption) (*Contact, error)
+	ListAll(ctx context.Context, opts ...RequestOption) ([]*Contact, error)
+	List(ctx context.Context, opts *ListOptions, reqOpts ...RequestOption) (*ContactPage, error)
+	IterateAll(ctx context.Context, opts *ListOptions, fn func(*Contact) error, reqOpts ...RequestOption) error
+	Delete(ctx context.Context, id uint64, opts ...RequestOption) error
+	HardDelete(ctx context.Context, id uint64, force bool, opts ...RequestOption) error
+	Restore(ctx context.Context, id uint64, opts ...RequestOption) error
+	ListAllContactFields(ctx context.Context, opts ...RequestOption) ([]*ContactField, error)
+	SearchContacts(ctx context.Context, keyword string, opts ...RequestOption) (contacts []*Contact, err error)
+	FilterContacts(ctx context.Context, queryStr string, opts *ListOptions, reqOpts ...RequestOption) (*ContactSearchResult, error)
+	SendInvite(ctx context.Context, id uint64, opts ...RequestOption) error
+	Merge(ctx context.Context, primaryID uint64, secondaryIDs []uint64, attrs *Contact, opts ...RequestOption) error
+	// BulkCreate submits contacts for asynchronous creation, returning one Job
+	// per submitted chunk (see WithBulkChunkSize)
+	BulkCreate(ctx context.Context, contacts []*Contact, opts ...RequestOption) ([]*Job, error)
+	// BulkUpdate applies attrs to every contact in ids asynchronously
+	BulkUpdate(ctx context.Context, ids []uint64, attrs *Contact, opts ...RequestOption) ([]*Job, error)
+	// BulkDelete soft-deletes every contact in ids asynchronously
+	BulkDelete(ctx context.Context, ids []uint64, opts ...RequestOption) ([]*Job, error)
 }
 
 type contactsClient struct {
@@ -117,8 +165,8 @@ type contactsClient struct {
 }
 
 // Create creates a new contact
-func (c *contactsClient) Create(t *Contact) (*Contact, error) {
-	req, err := c.client.newRequest(http.MethodPost, "contacts", t)
+func (c *contactsClient) Create(ctx context.Context, t *Contact, opts ...RequestOption) (*Contact, error) {
+	req, err := c.client.newRequest(ctx, http.MethodPost, "contacts", t, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -130,8 +178,8 @@ func (c *contactsClient) Create(t *Contact) (*Contact, error) {
 }
 
 // Update updates an existing contact
-func (c *contactsClient) Update(id uint64, t *Contact) (*Contact, error) {
-	req, err := c.client.newRequest(http.MethodPut, fmt.Sprintf("contacts/%d", id), t)
+func (c *contactsClient) Update(ctx context.Context, id uint64, t *Contact, opts ...RequestOption) (*Contact, error) {
+	req, err := c.client.newRequest(ctx, http.MethodPut, fmt.Sprintf("contacts/%d", id), t, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -143,8 +191,8 @@ func (c *contactsClient) Update(id uint64, t *Contact) (*Contact, error) {
 }
 
 // View gets an existing contact by id
-func (c *contactsClient) View(id uint64) (*Contact, error) {
-	req, err := c.client.newRequest(http.MethodGet, fmt.Sprintf("contacts/%d", id), nil)
+func (c *contactsClient) View(ctx context.Context, id uint64, opts ...RequestOption) (*Contact, error) {
+	req, err := c.client.newRequest(ctx, http.MethodGet, fmt.Sprintf("contacts/%d", id), nil, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -156,8 +204,8 @@ func (c *contactsClient) View(id uint64) (*Contact, error) {
 }
 
 // ListAll lists all existing contacts
-func (c *contactsClient) ListAll() ([]*Contact, error) {
-	req, err := c.client.newRequest(http.MethodGet, "contacts", nil)
+func (c *contactsClient) ListAll(ctx context.Context, opts ...RequestOption) ([]*Contact, error) {
+	req, err := c.client.newRequest(ctx, http.MethodGet, "contacts", nil, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -168,9 +216,55 @@ func (c *contactsClient) ListAll() ([]*Contact, error) {
 	return res, err
 }
 
+// List fetches a single page of contacts, optionally filtered and paginated
+// via opts. Use ContactPage.NextPage to fetch subsequent pages, or IterateAll
+// to walk every page automatically.
+func (c *contactsClient) List(ctx context.Context, opts *ListOptions, reqOpts ...RequestOption) (*ContactPage, error) {
+	if opts == nil {
+		opts = &ListOptions{}
+	}
+
+	page, err := listPage[*Contact](ctx, c.client, "contacts", opts, reqOpts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ContactPage{Contacts: page.Items, NextPage: page.NextPage}, nil
+}
+
+// IterateAll walks every page of contacts matching opts, invoking fn for each
+// contact in order. Iteration stops at the first error returned by fn.
+func (c *contactsClient) IterateAll(ctx context.Context, opts *ListOptions, fn func(*Contact) error, reqOpts ...RequestOption) error {
+	var pageOpts ListOptions
+	if opts != nil {
+		pageOpts = *opts
+	}
+	if pageOpts.Page == 0 {
+		pageOpts.Page = 1
+	}
+
+	for {
+		page, err := c.List(ctx, &pageOpts, reqOpts...)
+		if err != nil {
+			return err
+		}
+
+		for _, contact := range page.Contacts {
+			if err := fn(contact); err != nil {
+				return err
+			}
+		}
+
+		if page.NextPage == 0 {
+			return nil
+		}
+		pageOpts.Page = page.NextPage
+	}
+}
+
 // Delete soft-deletes an existing contact
-func (c *contactsClient) Delete(id uint64) error {
-	req, err := c.client.newRequest(http.MethodDelete, fmt.Sprintf("contacts/%d", id), nil)
+func (c *contactsClient) Delete(ctx context.Context, id uint64, opts ...RequestOption) error {
+	req, err := c.client.newRequest(ctx, http.MethodDelete, fmt.Sprintf("contacts/%d", id), nil, opts...)
 	if err != nil {
 		return err
 	}
@@ -179,7 +273,7 @@ func (c *contactsClient) Delete(id uint64) error {
 }
 
 // HardDelete deletes an existing contact permanently
-func (c *contactsClient) HardDelete(id uint64, force bool) error {
+func (c *contactsClient) HardDelete(ctx context.Context, id uint64, force bool, opts ...RequestOption) error {
 	type params struct {
 		// Contact ID
 		ID uint64 `json:"id"`
@@ -195,7 +289,7 @@ func (c *contactsClient) HardDelete(id uint64, force bool) error {
 		return err
 	}
 
-	req, err := c.client.newRequest(http.MethodDelete, fmt.Sprintf("contacts/%d/hard_delete", id), in)
+	req, err := c.client.newRequest(ctx, http.MethodDelete, fmt.Sprintf("contacts/%d/hard_delete", id), in, opts...)
 	if err != nil {
 		return err
 	}
@@ -204,8 +298,8 @@ func (c *contactsClient) HardDelete(id uint64, force bool) error {
 }
 
 // Restore restores previously deleted contact
-func (c *contactsClient) Restore(id uint64) error {
-	req, err := c.client.newRequest(http.MethodPut, fmt.Sprintf("contacts/%d/restore", id), nil)
+func (c *contactsClient) Restore(ctx context.Context, id uint64, opts ...RequestOption) error {
+	req, err := c.client.newRequest(ctx, http.MethodPut, fmt.Sprintf("contacts/%d/restore", id), nil, opts...)
 	if err != nil {
 		return err
 	}
@@ -214,8 +308,8 @@ func (c *contactsClient) Restore(id uint64) error {
 }
 
 // ListAllContactFields lists all contact fields
-func (c *contactsClient) ListAllContactFields() ([]*ContactField, error) {
-	req, err := c.client.newRequest(http.MethodGet, "contact_fields", nil)
+func (c *contactsClient) ListAllContactFields(ctx context.Context, opts ...RequestOption) ([]*ContactField, error) {
+	req, err := c.client.newRequest(ctx, http.MethodGet, "contact_fields", nil, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -227,8 +321,8 @@ func (c *contactsClient) ListAllContactFields() ([]*ContactField, error) {
 }
 
 // SearchContacts searches for a contact using their name
-func (c *contactsClient) SearchContacts(keyword string) (contacts []*Contact, err error) {
-	req, err := c.client.newRequest(http.MethodPost, fmt.Sprintf("contacts/autocomplete?term=%s", keyword), nil)
+func (c *contactsClient) SearchContacts(ctx context.Context, keyword string, opts ...RequestOption) (contacts []*Contact, err error) {
+	req, err := c.client.newRequest(ctx, http.MethodPost, fmt.Sprintf("contacts/autocomplete?term=%s", keyword), nil, opts...)
 	if err != nil {
 		return
 	}
@@ -237,9 +331,37 @@ func (c *contactsClient) SearchContacts(keyword string) (contacts []*Contact, er
 	return
 }
 
+// FilterContacts searches for contacts using Freshdesk's query DSL, e.g.
+// `"language:en AND created_at:>'2020-01-01'"`. Unlike SearchContacts, which
+// autocompletes on name, this supports arbitrary field filters and reports
+// the total number of matches.
+func (c *contactsClient) FilterContacts(ctx context.Context, queryStr string, opts *ListOptions, reqOpts ...RequestOption) (*ContactSearchResult, error) {
+	values := url.Values{"query": {fmt.Sprintf("%q", queryStr)}}
+	if opts != nil {
+		optValues, err := query.Values(opts)
+		if err != nil {
+			return nil, err
+		}
+		for key, vals := range optValues {
+			values[key] = vals
+		}
+	}
+	endpoint := "search/contacts?" + values.Encode()
+
+	req, err := c.client.newRequest(ctx, http.MethodGet, endpoint, nil, reqOpts...)
+	if err != nil {
+		return nil, err
+	}
+
+	res := new(ContactSearchResult)
+	err = c.client.do(req, res, http.StatusOK)
+
+	return res, err
+}
+
 // SendInvite used to send an activation email to an existing contact for email verification
-func (c *contactsClient) SendInvite(id uint64) error {
-	req, err := c.client.newRequest(http.MethodPost, fmt.Sprintf("contacts/%d/send_invite", id), nil)
+func (c *contactsClient) SendInvite(ctx context.Context, id uint64, opts ...RequestOption) error {
+	req, err := c.client.newRequest(ctx, http.MethodPost, fmt.Sprintf("contacts/%d/send_invite", id), nil, opts...)
 	if err != nil {
 		return err
 	}
@@ -248,7 +370,7 @@ func (c *contactsClient) SendInvite(id uint64) error {
 }
 
 // Merge used to merge two or more duplicate contacts together.
-func (c *contactsClient) Merge(primaryID uint64, secondaryIDs []uint64, attrs *Contact) error {
+func (c *contactsClient) Merge(ctx context.Context, primaryID uint64, secondaryIDs []uint64, attrs *Contact, opts ...RequestOption) error {
 	type params struct {
 		// ID of the primary contact
 		PrimaryContactID uint64 `json:"primary_contact_id"`
@@ -267,10 +389,78 @@ func (c *contactsClient) Merge(primaryID uint64, secondaryIDs []uint64, attrs *C
 		return err
 	}
 
-	req, err := c.client.newRequest(http.MethodPost, "contacts/merge", in)
+	req, err := c.client.newRequest(ctx, http.MethodPost, "contacts/merge", in, opts...)
 	if err != nil {
 		return err
 	}
 
 	return c.client.do(req, nil, http.StatusNoContent)
 }
+
+// BulkCreate submits contacts for asynchronous creation, returning one Job
+// per submitted chunk (see WithBulkChunkSize)
+func (c *contactsClient) BulkCreate(ctx context.Context, contacts []*Contact, opts ...RequestOption) ([]*Job, error) {
+	chunkSize := resolveBulkChunkSize(opts)
+
+	type params struct {
+		Contacts []*Contact `json:"contacts"`
+	}
+
+	var jobs []*Job
+	for start := 0; start < len(contacts); start += chunkSize {
+		end := min(start+chunkSize, len(contacts))
+
+		job, err := c.client.submitBulkJob(ctx, "contacts/bulk_create", &params{Contacts: contacts[start:end]}, opts...)
+		if err != nil {
+			return jobs, err
+		}
+		jobs = append(jobs, job)
+	}
+
+	return jobs, nil
+}
+
+// BulkUpdate applies attrs to every contact in ids asynchronously
+func (c *contactsClient) BulkUpdate(ctx context.Context, ids []uint64, attrs *Contact, opts ...RequestOption) ([]*Job, error) {
+	chunkSize := resolveBulkChunkSize(opts)
+
+	type params struct {
+		IDs        []uint64 `json:"ids"`
+		Properties *Contact `json:"properties,omitempty"`
+	}
+
+	var jobs []*Job
+	for start := 0; start < len(ids); start += chunkSize {
+		end := min(start+chunkSize, len(ids))
+
+		job, err := c.client.submitBulkJob(ctx, "contacts/bulk_update", &params{IDs: ids[start:end], Properties: attrs}, opts...)
+		if err != nil {
+			return jobs, err
+		}
+		jobs = append(jobs, job)
+	}
+
+	return jobs, nil
+}
+
+// BulkDelete soft-deletes every contact in ids asynchronously
+func (c *contactsClient) BulkDelete(ctx context.Context, ids []uint64, opts ...RequestOption) ([]*Job, error) {
+	chunkSize := resolveBulkChunkSize(opts)
+
+	type params struct {
+		IDs []uint64 `json:"ids"`
+	}
+
+	var jobs []*Job
+	for start := 0; start < len(ids); start += chunkSize {
+		end := min(start+chunkSize, len(ids))
+
+		job, err := c.client.submitBulkJob(ctx, "contacts/bulk_delete", &params{IDs: ids[start:end]}, opts...)
+		if err != nil {
+			return jobs, err
+		}
+		jobs = append(jobs, job)
+	}
+
+	return jobs, nil
+}
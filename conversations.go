@@ -0,0 +1,121 @@
+package freshdesk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+type Conversation struct {
+	// ID of the conversation entry
+	ID uint64 `json:"id,omitempty"`
+	// ID of the ticket this conversation entry belongs to
+	TicketID uint64 `json:"ticket_id,omitempty"`
+	// HTML content of the conversation entry
+	Body string `json:"body"`
+	// Set to true if the entry is a private note, false for a public reply
+	Private bool `json:"private"`
+	// User ID of the agent or contact that authored the entry
+	UserID uint64 `json:"user_id,omitempty"`
+	// Email addresses of additional recipients added to a reply
+	CCEmails []string `json:"cc_emails,omitempty"`
+	// Entry creation timestamp
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	// Entry updated timestamp
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+}
+
+// ConversationListOptions controls pagination for ConversationsClient.ListForTicket.
+type ConversationListOptions struct {
+	// Page number to fetch, starting at 1
+	Page int `url:"page,omitempty"`
+	// Number of entries per page (max 100)
+	PerPage int `url:"per_page,omitempty"`
+}
+
+// ConversationPage is a page of a ticket's replies and notes.
+type ConversationPage = Page[*Conversation]
+
+type ConversationsClient interface {
+	// CreateReply posts a public reply, visible to the requester, on a ticket
+	CreateReply(ctx context.Context, ticketID uint64, body string, opts ...RequestOption) (*Conversation, error)
+	// CreateNote posts a note on a ticket, marked private when private is true
+	CreateNote(ctx context.Context, ticketID uint64, body string, private bool, opts ...RequestOption) (*Conversation, error)
+	// ListForTicket fetches a single page of a ticket's replies and notes, oldest first
+	ListForTicket(ctx context.Context, ticketID uint64, opts *ConversationListOptions, reqOpts ...RequestOption) (*ConversationPage, error)
+	// Update edits an existing reply or note's body. Pass a non-nil private to
+	// also change its visibility; leave it nil to leave visibility unchanged.
+	Update(ctx context.Context, id uint64, body string, private *bool, opts ...RequestOption) (*Conversation, error)
+	Delete(ctx context.Context, id uint64, opts ...RequestOption) error
+}
+
+type conversationsClient struct {
+	*client
+}
+
+// CreateReply posts a public reply, visible to the requester, on a ticket
+func (c *conversationsClient) CreateReply(ctx context.Context, ticketID uint64, body string, opts ...RequestOption) (*Conversation, error) {
+	in := &Conversation{Body: body}
+	req, err := c.client.newRequest(ctx, http.MethodPost, fmt.Sprintf("tickets/%d/reply", ticketID), in, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	res := new(Conversation)
+	err = c.client.do(req, res, http.StatusCreated)
+
+	return res, err
+}
+
+// CreateNote posts a note on a ticket, marked private when private is true
+func (c *conversationsClient) CreateNote(ctx context.Context, ticketID uint64, body string, private bool, opts ...RequestOption) (*Conversation, error) {
+	in := &Conversation{Body: body, Private: private}
+	req, err := c.client.newRequest(ctx, http.MethodPost, fmt.Sprintf("tickets/%d/notes", ticketID), in, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	res := new(Conversation)
+	err = c.client.do(req, res, http.StatusCreated)
+
+	return res, err
+}
+
+// ListForTicket fetches a single page of a ticket's replies and notes, oldest first
+func (c *conversationsClient) ListForTicket(ctx context.Context, ticketID uint64, opts *ConversationListOptions, reqOpts ...RequestOption) (*ConversationPage, error) {
+	if opts == nil {
+		opts = &ConversationListOptions{}
+	}
+
+	return listPage[*Conversation](ctx, c.client, fmt.Sprintf("tickets/%d/conversations", ticketID), opts, reqOpts...)
+}
+
+// Update edits an existing reply or note's body. Pass a non-nil private to
+// also change its visibility; leave it nil to leave visibility unchanged.
+func (c *conversationsClient) Update(ctx context.Context, id uint64, body string, private *bool, opts ...RequestOption) (*Conversation, error) {
+	type params struct {
+		Body    string `json:"body"`
+		Private *bool  `json:"private,omitempty"`
+	}
+
+	req, err := c.client.newRequest(ctx, http.MethodPut, fmt.Sprintf("conversations/%d", id), &params{Body: body, Private: private}, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	res := new(Conversation)
+	err = c.client.do(req, res, http.StatusOK)
+
+	return res, err
+}
+
+// Delete deletes an existing reply or note
+func (c *conversationsClient) Delete(ctx context.Context, id uint64, opts ...RequestOption) error {
+	req, err := c.client.newRequest(ctx, http.MethodDelete, fmt.Sprintf("conversations/%d", id), nil, opts...)
+	if err != nil {
+		return err
+	}
+
+	return c.client.do(req, nil, http.StatusNoContent)
+}
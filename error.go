@@ -12,6 +12,9 @@ type APIError struct {
 	Description string       `json:"description"`
 	Errors      []FieldError `json:"errors"`
 	StatusCode  int
+	// RateLimit is populated when StatusCode is 429, reflecting the
+	// rate-limit headers Freshdesk returned alongside the rejection
+	RateLimit *RateLimitStatus
 }
 
 type FieldError struct {
@@ -0,0 +1,344 @@
+package freshdesktest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/SamuilOlegovich/go-freshdesk"
+)
+
+// FakeContactsClient is an in-memory freshdesk.ContactsClient, suitable for
+// hermetic unit tests that would otherwise need a live Freshdesk tenant. It
+// reproduces the behaviors callers tend to depend on: duplicate-email
+// conflicts carry the same payload freshdesk.APIError.IsDuplicate() expects,
+// and Delete/Restore follow Freshdesk's soft-delete semantics.
+type FakeContactsClient struct {
+	mu       sync.Mutex
+	nextID   uint64
+	nextJob  uint64
+	contacts map[uint64]*freshdesk.Contact
+}
+
+var _ freshdesk.ContactsClient = (*FakeContactsClient)(nil)
+
+// NewFakeContactsClient returns an empty FakeContactsClient.
+func NewFakeContactsClient() *FakeContactsClient {
+	return &FakeContactsClient{contacts: make(map[uint64]*freshdesk.Contact)}
+}
+
+func cloneContact(c *freshdesk.Contact) *freshdesk.Contact {
+	clone := *c
+	return &clone
+}
+
+func notFoundError() *freshdesk.APIError {
+	return freshdesk.NewApiError(http.StatusNotFound, http.StatusOK, "", `{"description":"Contact not found"}`)
+}
+
+func duplicateEmailError(userID uint64) *freshdesk.APIError {
+	err := freshdesk.NewApiError(http.StatusConflict, http.StatusCreated, "", "")
+	err.Errors = []freshdesk.FieldError{{
+		Field:          "email",
+		Code:           "duplicate_value",
+		AdditionalInfo: map[string]interface{}{"user_id": float64(userID)},
+	}}
+	return err
+}
+
+func (f *FakeContactsClient) findByEmail(email string) (*freshdesk.Contact, bool) {
+	if email == "" {
+		return nil, false
+	}
+	for _, c := range f.contacts {
+		if !c.Deleted && c.Email == email {
+			return c, true
+		}
+	}
+	return nil, false
+}
+
+// sortedContacts returns every stored contact ordered by ID, for deterministic iteration.
+func (f *FakeContactsClient) sortedContacts() []*freshdesk.Contact {
+	contacts := make([]*freshdesk.Contact, 0, len(f.contacts))
+	for _, c := range f.contacts {
+		contacts = append(contacts, c)
+	}
+	sort.Slice(contacts, func(i, j int) bool { return contacts[i].ID < contacts[j].ID })
+	return contacts
+}
+
+func (f *FakeContactsClient) Create(_ context.Context, t *freshdesk.Contact, _ ...freshdesk.RequestOption) (*freshdesk.Contact, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if existing, ok := f.findByEmail(t.Email); ok {
+		return nil, duplicateEmailError(existing.ID)
+	}
+
+	f.nextID++
+	stored := cloneContact(t)
+	stored.ID = f.nextID
+	stored.Active = true
+	f.contacts[stored.ID] = stored
+
+	return cloneContact(stored), nil
+}
+
+func (f *FakeContactsClient) Update(_ context.Context, id uint64, t *freshdesk.Contact, _ ...freshdesk.RequestOption) (*freshdesk.Contact, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	existing, ok := f.contacts[id]
+	if !ok {
+		return nil, notFoundError()
+	}
+	if other, ok := f.findByEmail(t.Email); ok && other.ID != id {
+		return nil, duplicateEmailError(other.ID)
+	}
+
+	updated := cloneContact(t)
+	updated.ID = id
+	updated.Deleted = existing.Deleted
+	f.contacts[id] = updated
+
+	return cloneContact(updated), nil
+}
+
+func (f *FakeContactsClient) View(_ context.Context, id uint64, _ ...freshdesk.RequestOption) (*freshdesk.Contact, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	c, ok := f.contacts[id]
+	if !ok {
+		return nil, notFoundError()
+	}
+	return cloneContact(c), nil
+}
+
+func (f *FakeContactsClient) ListAll(_ context.Context, _ ...freshdesk.RequestOption) ([]*freshdesk.Contact, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var out []*freshdesk.Contact
+	for _, c := range f.sortedContacts() {
+		out = append(out, cloneContact(c))
+	}
+	return out, nil
+}
+
+func (f *FakeContactsClient) List(_ context.Context, opts *freshdesk.ListOptions, _ ...freshdesk.RequestOption) (*freshdesk.ContactPage, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	page, perPage := 1, 30
+	if opts != nil {
+		if opts.Page > 0 {
+			page = opts.Page
+		}
+		if opts.PerPage > 0 {
+			perPage = opts.PerPage
+		}
+	}
+
+	all := f.sortedContacts()
+	start := min((page-1)*perPage, len(all))
+	end := min(start+perPage, len(all))
+
+	out := make([]*freshdesk.Contact, 0, end-start)
+	for _, c := range all[start:end] {
+		out = append(out, cloneContact(c))
+	}
+
+	nextPage := 0
+	if end < len(all) {
+		nextPage = page + 1
+	}
+
+	return &freshdesk.ContactPage{Contacts: out, NextPage: nextPage}, nil
+}
+
+func (f *FakeContactsClient) IterateAll(ctx context.Context, opts *freshdesk.ListOptions, fn func(*freshdesk.Contact) error, reqOpts ...freshdesk.RequestOption) error {
+	var pageOpts freshdesk.ListOptions
+	if opts != nil {
+		pageOpts = *opts
+	}
+	if pageOpts.Page == 0 {
+		pageOpts.Page = 1
+	}
+
+	for {
+		page, err := f.List(ctx, &pageOpts, reqOpts...)
+		if err != nil {
+			return err
+		}
+		for _, c := range page.Contacts {
+			if err := fn(c); err != nil {
+				return err
+			}
+		}
+		if page.NextPage == 0 {
+			return nil
+		}
+		pageOpts.Page = page.NextPage
+	}
+}
+
+func (f *FakeContactsClient) Delete(_ context.Context, id uint64, _ ...freshdesk.RequestOption) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	c, ok := f.contacts[id]
+	if !ok {
+		return notFoundError()
+	}
+	c.Deleted = true
+	return nil
+}
+
+func (f *FakeContactsClient) HardDelete(_ context.Context, id uint64, force bool, _ ...freshdesk.RequestOption) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	c, ok := f.contacts[id]
+	if !ok {
+		return notFoundError()
+	}
+	if !c.Deleted && !force {
+		return freshdesk.NewApiError(http.StatusBadRequest, http.StatusOK, "", `{"description":"Contact must be soft-deleted first, or force must be true"}`)
+	}
+	delete(f.contacts, id)
+	return nil
+}
+
+func (f *FakeContactsClient) Restore(_ context.Context, id uint64, _ ...freshdesk.RequestOption) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	c, ok := f.contacts[id]
+	if !ok {
+		return notFoundError()
+	}
+	c.Deleted = false
+	return nil
+}
+
+func (f *FakeContactsClient) ListAllContactFields(_ context.Context, _ ...freshdesk.RequestOption) ([]*freshdesk.ContactField, error) {
+	return nil, nil
+}
+
+func (f *FakeContactsClient) SearchContacts(_ context.Context, keyword string, _ ...freshdesk.RequestOption) ([]*freshdesk.Contact, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var matches []*freshdesk.Contact
+	for _, c := range f.sortedContacts() {
+		if c.Deleted {
+			continue
+		}
+		if strings.Contains(strings.ToLower(c.Name), strings.ToLower(keyword)) {
+			matches = append(matches, cloneContact(c))
+		}
+	}
+	return matches, nil
+}
+
+// FilterContacts supports a useful subset of Freshdesk's query DSL: clauses
+// of the form `field:'value'` joined with AND, matched against a contact's
+// JSON field names (e.g. `language`) and values exactly; matching is
+// case-sensitive. It does not evaluate operators like `>` or `<`.
+func (f *FakeContactsClient) FilterContacts(_ context.Context, queryStr string, _ *freshdesk.ListOptions, _ ...freshdesk.RequestOption) (*freshdesk.ContactSearchResult, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	clauses := parseFilterClauses(queryStr)
+
+	var matched []*freshdesk.Contact
+	for _, c := range f.sortedContacts() {
+		if c.Deleted {
+			continue
+		}
+		if matchesClauses(c, clauses) {
+			matched = append(matched, cloneContact(c))
+		}
+	}
+
+	return &freshdesk.ContactSearchResult{Total: len(matched), Contacts: matched}, nil
+}
+
+func (f *FakeContactsClient) SendInvite(_ context.Context, id uint64, _ ...freshdesk.RequestOption) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if _, ok := f.contacts[id]; !ok {
+		return notFoundError()
+	}
+	return nil
+}
+
+func (f *FakeContactsClient) Merge(_ context.Context, primaryID uint64, secondaryIDs []uint64, attrs *freshdesk.Contact, _ ...freshdesk.RequestOption) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if _, ok := f.contacts[primaryID]; !ok {
+		return notFoundError()
+	}
+	for _, id := range secondaryIDs {
+		if _, ok := f.contacts[id]; !ok {
+			return notFoundError()
+		}
+	}
+
+	if attrs != nil {
+		merged := *attrs
+		merged.ID = primaryID
+		f.contacts[primaryID] = &merged
+	}
+	for _, id := range secondaryIDs {
+		delete(f.contacts, id)
+	}
+	return nil
+}
+
+func (f *FakeContactsClient) submitJob(count int) *freshdesk.Job {
+	f.nextJob++
+	return &freshdesk.Job{
+		ID:        fmt.Sprintf("fake-job-%d", f.nextJob),
+		Status:    freshdesk.JobStatusCompleted,
+		Total:     count,
+		Processed: count,
+	}
+}
+
+// BulkCreate, BulkUpdate and BulkDelete apply their changes synchronously and
+// return a single completed Job covering every record; WithBulkChunkSize is
+// not applied, so they never split the input into several Jobs.
+func (f *FakeContactsClient) BulkCreate(ctx context.Context, contacts []*freshdesk.Contact, opts ...freshdesk.RequestOption) ([]*freshdesk.Job, error) {
+	for _, c := range contacts {
+		if _, err := f.Create(ctx, c, opts...); err != nil {
+			return nil, err
+		}
+	}
+	return []*freshdesk.Job{f.submitJob(len(contacts))}, nil
+}
+
+func (f *FakeContactsClient) BulkUpdate(ctx context.Context, ids []uint64, attrs *freshdesk.Contact, opts ...freshdesk.RequestOption) ([]*freshdesk.Job, error) {
+	for _, id := range ids {
+		if _, err := f.Update(ctx, id, attrs, opts...); err != nil {
+			return nil, err
+		}
+	}
+	return []*freshdesk.Job{f.submitJob(len(ids))}, nil
+}
+
+func (f *FakeContactsClient) BulkDelete(ctx context.Context, ids []uint64, opts ...freshdesk.RequestOption) ([]*freshdesk.Job, error) {
+	for _, id := range ids {
+		if err := f.Delete(ctx, id, opts...); err != nil {
+			return nil, err
+		}
+	}
+	return []*freshdesk.Job{f.submitJob(len(ids))}, nil
+}
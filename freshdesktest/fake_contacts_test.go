@@ -0,0 +1,124 @@
+package freshdesktest
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/SamuilOlegovich/go-freshdesk"
+)
+
+func TestFakeContactsClientCreateDuplicateEmail(t *testing.T) {
+	f := NewFakeContactsClient()
+	ctx := context.Background()
+
+	first, err := f.Create(ctx, &freshdesk.Contact{Name: "Ada", Email: "ada@example.com"})
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	_, err = f.Create(ctx, &freshdesk.Contact{Name: "Imposter", Email: "ada@example.com"})
+	if err == nil {
+		t.Fatal("Create() with a duplicate email expected an error, got nil")
+	}
+
+	apiErr, ok := err.(*freshdesk.APIError)
+	if !ok {
+		t.Fatalf("Create() error type = %T, want *freshdesk.APIError", err)
+	}
+	if apiErr.StatusCode != http.StatusConflict {
+		t.Errorf("StatusCode = %d, want %d", apiErr.StatusCode, http.StatusConflict)
+	}
+	isDup, userID := apiErr.IsDuplicate()
+	if !isDup {
+		t.Fatal("IsDuplicate() = false, want true")
+	}
+	if userID != first.ID {
+		t.Errorf("IsDuplicate() userID = %d, want %d", userID, first.ID)
+	}
+}
+
+func TestFakeContactsClientDeleteThenHardDelete(t *testing.T) {
+	f := NewFakeContactsClient()
+	ctx := context.Background()
+
+	created, err := f.Create(ctx, &freshdesk.Contact{Name: "Ada", Email: "ada@example.com"})
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	if err := f.HardDelete(ctx, created.ID, false); err == nil {
+		t.Fatal("HardDelete() on a contact that is not soft-deleted expected an error, got nil")
+	}
+
+	if err := f.Delete(ctx, created.ID); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+
+	if err := f.HardDelete(ctx, created.ID, false); err != nil {
+		t.Fatalf("HardDelete() after Delete() error = %v", err)
+	}
+
+	if _, err := f.View(ctx, created.ID); err == nil {
+		t.Fatal("View() after HardDelete() expected an error, got nil")
+	}
+}
+
+func TestFakeContactsClientHardDeleteForce(t *testing.T) {
+	f := NewFakeContactsClient()
+	ctx := context.Background()
+
+	created, err := f.Create(ctx, &freshdesk.Contact{Name: "Ada", Email: "ada@example.com"})
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	if err := f.HardDelete(ctx, created.ID, true); err != nil {
+		t.Fatalf("HardDelete(force=true) error = %v", err)
+	}
+}
+
+func TestFakeContactsClientFilterContacts(t *testing.T) {
+	f := NewFakeContactsClient()
+	ctx := context.Background()
+
+	en, err := f.Create(ctx, &freshdesk.Contact{Name: "Ada", Email: "ada@example.com", Language: strPtr("en")})
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if _, err := f.Create(ctx, &freshdesk.Contact{Name: "Grace", Email: "grace@example.com", Language: strPtr("fr")}); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	result, err := f.FilterContacts(ctx, `language:'en'`, nil)
+	if err != nil {
+		t.Fatalf("FilterContacts() error = %v", err)
+	}
+	if result.Total != 1 || len(result.Contacts) != 1 {
+		t.Fatalf("FilterContacts() returned %d contacts, want 1", result.Total)
+	}
+	if result.Contacts[0].ID != en.ID {
+		t.Errorf("FilterContacts() matched ID = %d, want %d", result.Contacts[0].ID, en.ID)
+	}
+
+	deleted, err := f.FilterContacts(ctx, `language:'es'`, nil)
+	if err != nil {
+		t.Fatalf("FilterContacts() error = %v", err)
+	}
+	if deleted.Total != 0 {
+		t.Errorf("FilterContacts() with no matches returned %d contacts, want 0", deleted.Total)
+	}
+
+	if err := f.Delete(ctx, en.ID); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	afterDelete, err := f.FilterContacts(ctx, `language:'en'`, nil)
+	if err != nil {
+		t.Fatalf("FilterContacts() error = %v", err)
+	}
+	if afterDelete.Total != 0 {
+		t.Error("FilterContacts() matched a soft-deleted contact, want it excluded")
+	}
+}
+
+func strPtr(s string) *string { return &s }
@@ -0,0 +1,56 @@
+package freshdesktest
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/SamuilOlegovich/go-freshdesk"
+)
+
+type filterClause struct {
+	field string
+	value string
+}
+
+// parseFilterClauses splits a Freshdesk query DSL string like
+// `"language:en AND company_id:'12'"` into its individual field:value
+// clauses. Operators other than equality (e.g. `>`, `<`) are not supported.
+func parseFilterClauses(query string) []filterClause {
+	var clauses []filterClause
+	for _, part := range strings.Split(query, " AND ") {
+		part = strings.TrimSpace(part)
+		field, value, ok := strings.Cut(part, ":")
+		if !ok {
+			continue
+		}
+		value = strings.Trim(strings.TrimSpace(value), `'"`)
+		clauses = append(clauses, filterClause{field: strings.TrimSpace(field), value: value})
+	}
+	return clauses
+}
+
+// matchesClauses reports whether every clause matches the contact's JSON
+// representation, comparing field values as strings.
+func matchesClauses(c *freshdesk.Contact, clauses []filterClause) bool {
+	if len(clauses) == 0 {
+		return true
+	}
+
+	raw, err := json.Marshal(c)
+	if err != nil {
+		return false
+	}
+	var fields map[string]interface{}
+	if err := json.Unmarshal(raw, &fields); err != nil {
+		return false
+	}
+
+	for _, clause := range clauses {
+		v, ok := fields[clause.field]
+		if !ok || fmt.Sprint(v) != clause.value {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,81 @@
+// Package freshdesktest provides test doubles for exercising code built on
+// top of the freshdesk package without making real network calls.
+package freshdesktest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/SamuilOlegovich/go-freshdesk"
+)
+
+// TestServer is an httptest.Server that records every request it receives
+// and lets tests script a response per method+path.
+type TestServer struct {
+	t      testing.TB
+	server *httptest.Server
+
+	mu       sync.Mutex
+	routes   map[string]http.HandlerFunc
+	requests []*http.Request
+}
+
+// NewTestServer starts a TestServer, registering its Close with t.Cleanup.
+func NewTestServer(t testing.TB) *TestServer {
+	ts := &TestServer{t: t, routes: make(map[string]http.HandlerFunc)}
+	ts.server = httptest.NewServer(http.HandlerFunc(ts.handle))
+	t.Cleanup(ts.server.Close)
+	return ts
+}
+
+func (ts *TestServer) handle(w http.ResponseWriter, r *http.Request) {
+	ts.mu.Lock()
+	ts.requests = append(ts.requests, r)
+	handler, ok := ts.routes[r.Method+" "+r.URL.Path]
+	ts.mu.Unlock()
+
+	if !ok {
+		http.Error(w, "freshdesktest: no handler registered for "+r.Method+" "+r.URL.Path, http.StatusNotImplemented)
+		return
+	}
+	handler(w, r)
+}
+
+// Handle scripts handler to run for every request matching method and path,
+// e.g. ts.Handle(http.MethodGet, "/contacts/1", handler).
+func (ts *TestServer) Handle(method, path string, handler http.HandlerFunc) {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	ts.routes[method+" "+path] = handler
+}
+
+// HandleJSON scripts a fixed JSON response with the given status code for
+// every request matching method and path.
+func (ts *TestServer) HandleJSON(method, path string, status int, body interface{}) {
+	ts.Handle(method, path, func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_ = json.NewEncoder(w).Encode(body)
+	})
+}
+
+// Requests returns every request the server has received so far, in order.
+func (ts *TestServer) Requests() []*http.Request {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	return append([]*http.Request(nil), ts.requests...)
+}
+
+// ClientOptions returns the freshdesk.ClientOption values needed to point a
+// Client at this server instead of a real Freshdesk tenant:
+//
+//	c, err := freshdesk.NewClient("test", "key", ts.ClientOptions()...)
+func (ts *TestServer) ClientOptions() []freshdesk.ClientOption {
+	return []freshdesk.ClientOption{
+		freshdesk.WithHTTPClient(ts.server.Client()),
+		freshdesk.WithBaseURL(ts.server.URL + "/"),
+	}
+}
@@ -0,0 +1,107 @@
+package freshdesk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+type Group struct {
+	// ID of the group
+	ID uint64 `json:"id,omitempty"`
+	// Name of the group
+	Name string `json:"name"`
+	// Description of the group
+	Description *string `json:"description,omitempty"`
+	// Agent IDs belonging to the group
+	AgentIDs []uint64 `json:"agent_ids,omitempty"`
+	// ID of the agent to whom an unassigned ticket is escalated
+	EscalateTo *uint64 `json:"escalate_to,omitempty"`
+	// Time in seconds after which an unassigned ticket is escalated
+	UnassignedFor *string `json:"unassigned_for,omitempty"`
+	// Group creation timestamp
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	// Group updated timestamp
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+}
+
+// GroupListOptions controls pagination for GroupsClient.List.
+type GroupListOptions struct {
+	// Page number to fetch, starting at 1
+	Page int `url:"page,omitempty"`
+	// Number of groups per page (max 100)
+	PerPage int `url:"per_page,omitempty"`
+}
+
+type GroupPage = Page[*Group]
+
+type GroupsClient interface {
+	Create(ctx context.Context, g *Group, opts ...RequestOption) (*Group, error)
+	Update(ctx context.Context, id uint64, g *Group, opts ...RequestOption) (*Group, error)
+	View(ctx context.Context, id uint64, opts ...RequestOption) (*Group, error)
+	List(ctx context.Context, opts *GroupListOptions, reqOpts ...RequestOption) (*GroupPage, error)
+	Delete(ctx context.Context, id uint64, opts ...RequestOption) error
+}
+
+type groupsClient struct {
+	*client
+}
+
+// Create creates a new group
+func (c *groupsClient) Create(ctx context.Context, g *Group, opts ...RequestOption) (*Group, error) {
+	req, err := c.client.newRequest(ctx, http.MethodPost, "groups", g, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	res := new(Group)
+	err = c.client.do(req, res, http.StatusCreated)
+
+	return res, err
+}
+
+// Update updates an existing group
+func (c *groupsClient) Update(ctx context.Context, id uint64, g *Group, opts ...RequestOption) (*Group, error) {
+	req, err := c.client.newRequest(ctx, http.MethodPut, fmt.Sprintf("groups/%d", id), g, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	res := new(Group)
+	err = c.client.do(req, res, http.StatusOK)
+
+	return res, err
+}
+
+// View gets an existing group by id
+func (c *groupsClient) View(ctx context.Context, id uint64, opts ...RequestOption) (*Group, error) {
+	req, err := c.client.newRequest(ctx, http.MethodGet, fmt.Sprintf("groups/%d", id), nil, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	res := new(Group)
+	err = c.client.do(req, res, http.StatusOK)
+
+	return res, err
+}
+
+// List fetches a single page of groups
+func (c *groupsClient) List(ctx context.Context, opts *GroupListOptions, reqOpts ...RequestOption) (*GroupPage, error) {
+	if opts == nil {
+		opts = &GroupListOptions{}
+	}
+
+	return listPage[*Group](ctx, c.client, "groups", opts, reqOpts...)
+}
+
+// Delete deletes an existing group
+func (c *groupsClient) Delete(ctx context.Context, id uint64, opts ...RequestOption) error {
+	req, err := c.client.newRequest(ctx, http.MethodDelete, fmt.Sprintf("groups/%d", id), nil, opts...)
+	if err != nil {
+		return err
+	}
+
+	return c.client.do(req, nil, http.StatusNoContent)
+}
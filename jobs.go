@@ -0,0 +1,104 @@
+package freshdesk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// JobStatus is the lifecycle state of an asynchronous bulk job.
+type JobStatus string
+
+const (
+	JobStatusQueued     JobStatus = "QUEUED"
+	JobStatusInProgress JobStatus = "IN PROGRESS"
+	JobStatusCompleted  JobStatus = "COMPLETED"
+	JobStatusFailed     JobStatus = "FAILED"
+)
+
+// Job is the handle returned by a bulk operation, used to poll for completion.
+type Job struct {
+	// ID of the job
+	ID string `json:"id"`
+	// Status of the job
+	Status JobStatus `json:"status"`
+	// Total number of records submitted to the job
+	Total int `json:"total,omitempty"`
+	// Number of records processed so far
+	Processed int `json:"processed_count,omitempty"`
+	// Errors encountered for individual records, if any
+	Errors []FieldError `json:"errors,omitempty"`
+}
+
+// Done reports whether the job has reached a terminal state.
+func (j *Job) Done() bool {
+	return j.Status == JobStatusCompleted || j.Status == JobStatusFailed
+}
+
+// JobPollOptions controls how WaitFor polls a job for completion.
+type JobPollOptions struct {
+	// InitialInterval between polls, defaults to 1s
+	InitialInterval time.Duration
+	// MaxInterval the poll interval is allowed to back off to, defaults to 30s
+	MaxInterval time.Duration
+}
+
+type JobsClient interface {
+	// Get fetches the current state of a job by id
+	Get(ctx context.Context, jobID string, opts ...RequestOption) (*Job, error)
+	// WaitFor polls a job with exponential backoff until it reaches a terminal
+	// state or ctx is done
+	WaitFor(ctx context.Context, jobID string, pollOpts JobPollOptions, opts ...RequestOption) (*Job, error)
+}
+
+type jobsClient struct {
+	*client
+}
+
+// Get fetches the current state of a job by id
+func (c *jobsClient) Get(ctx context.Context, jobID string, opts ...RequestOption) (*Job, error) {
+	req, err := c.client.newRequest(ctx, http.MethodGet, fmt.Sprintf("jobs/%s", jobID), nil, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	res := new(Job)
+	err = c.client.do(req, res, http.StatusOK)
+
+	return res, err
+}
+
+// WaitFor polls a job with exponential backoff until it reaches a terminal
+// state or ctx is done
+func (c *jobsClient) WaitFor(ctx context.Context, jobID string, pollOpts JobPollOptions, opts ...RequestOption) (*Job, error) {
+	interval := pollOpts.InitialInterval
+	if interval <= 0 {
+		interval = time.Second
+	}
+	maxInterval := pollOpts.MaxInterval
+	if maxInterval <= 0 {
+		maxInterval = 30 * time.Second
+	}
+
+	for {
+		job, err := c.Get(ctx, jobID, opts...)
+		if err != nil {
+			return nil, err
+		}
+		if job.Done() {
+			return job, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(interval):
+		}
+
+		interval *= 2
+		if interval > maxInterval {
+			interval = maxInterval
+		}
+	}
+}
@@ -0,0 +1,175 @@
+package freshdesk
+
+import (
+	"net/http"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// ClientOption configures a Client at construction time.
+type ClientOption interface {
+	applyClient(*clientConfig)
+}
+
+type clientConfig struct {
+	logger             Logger
+	transport          http.RoundTripper
+	userAgent          string
+	httpClient         HTTPDoer
+	baseURL            string
+	rateLimiter        *rate.Limiter
+	rateLimitThreshold int
+}
+
+type clientOptionFunc func(*clientConfig)
+
+func (f clientOptionFunc) applyClient(c *clientConfig) { f(c) }
+
+// WithLogger sets the logger used by the underlying retryable HTTP client.
+func WithLogger(log Logger) ClientOption {
+	return clientOptionFunc(func(c *clientConfig) {
+		c.logger = log
+	})
+}
+
+// WithTransport sets the http.RoundTripper used by the underlying retryable HTTP client.
+func WithTransport(transport http.RoundTripper) ClientOption {
+	return clientOptionFunc(func(c *clientConfig) {
+		c.transport = transport
+	})
+}
+
+// WithUserAgent sets the User-Agent header sent with every request.
+func WithUserAgent(userAgent string) ClientOption {
+	return clientOptionFunc(func(c *clientConfig) {
+		c.userAgent = userAgent
+	})
+}
+
+// WithHTTPClient overrides the HTTPDoer used to issue requests, bypassing the
+// default retryablehttp-backed client entirely. Accepting the narrower
+// HTTPDoer interface rather than *http.Client lets tests supply a fake that
+// never touches the network; see the freshdesktest package.
+func WithHTTPClient(httpClient HTTPDoer) ClientOption {
+	return clientOptionFunc(func(c *clientConfig) {
+		c.httpClient = httpClient
+	})
+}
+
+// WithBaseURL overrides the default https://{subdomain}.freshdesk.com/api/v2/
+// base URL, e.g. to point a Client at a test server.
+func WithBaseURL(baseURL string) ClientOption {
+	return clientOptionFunc(func(c *clientConfig) {
+		c.baseURL = baseURL
+	})
+}
+
+// WithRateLimiter pre-throttles outbound requests against a token bucket, so
+// the client slows down before Freshdesk starts returning 429s rather than
+// relying on retries after the fact.
+func WithRateLimiter(limiter *rate.Limiter) ClientOption {
+	return clientOptionFunc(func(c *clientConfig) {
+		c.rateLimiter = limiter
+	})
+}
+
+// WithRateLimitThreshold sets how many requests may remain in the current
+// Freshdesk rate-limit window before the retry backoff starts waiting the
+// full maxWait between attempts, rather than the usual exponential curve.
+// Defaults to 5.
+func WithRateLimitThreshold(remaining int) ClientOption {
+	return clientOptionFunc(func(c *clientConfig) {
+		c.rateLimitThreshold = remaining
+	})
+}
+
+// RequestOption configures a single call made through the Client.
+type RequestOption interface {
+	applyRequest(*requestConfig)
+}
+
+type requestConfig struct {
+	timeout                  time.Duration
+	headers                  http.Header
+	httpClient               HTTPDoer
+	baseURL                  string
+	idempotencyKey           string
+	idempotencyKeyExpiration time.Duration
+	autoIdempotencyKey       bool
+	bulkChunkSize            int
+}
+
+func newRequestConfig() *requestConfig {
+	return &requestConfig{headers: make(http.Header)}
+}
+
+type requestOptionFunc func(*requestConfig)
+
+func (f requestOptionFunc) applyRequest(c *requestConfig) { f(c) }
+
+// WithRequestTimeout bounds how long a single call is allowed to take,
+// independent of any deadline already set on the passed context.Context.
+func WithRequestTimeout(d time.Duration) RequestOption {
+	return requestOptionFunc(func(c *requestConfig) {
+		c.timeout = d
+	})
+}
+
+// WithHeader adds an extra header to the outgoing request.
+func WithHeader(key, value string) RequestOption {
+	return requestOptionFunc(func(c *requestConfig) {
+		c.headers.Add(key, value)
+	})
+}
+
+// WithRequestHTTPClient overrides the HTTPDoer used for this call only.
+func WithRequestHTTPClient(httpClient HTTPDoer) RequestOption {
+	return requestOptionFunc(func(c *requestConfig) {
+		c.httpClient = httpClient
+	})
+}
+
+// WithRequestBaseURL overrides the base URL for this call only, e.g. to
+// target a different Freshdesk tenant without constructing a new Client.
+func WithRequestBaseURL(baseURL string) RequestOption {
+	return requestOptionFunc(func(c *requestConfig) {
+		c.baseURL = baseURL
+	})
+}
+
+// WithIdempotencyKey sets the Idempotency-Key header on a mutating request,
+// so that retrying it after a transient network failure (e.g. a timed-out
+// Create or Merge) reaches the same Freshdesk record instead of creating a
+// duplicate.
+func WithIdempotencyKey(key string) RequestOption {
+	return requestOptionFunc(func(c *requestConfig) {
+		c.idempotencyKey = key
+	})
+}
+
+// WithIdempotencyKeyExpiration sets how long Freshdesk should hold onto the
+// idempotency key for this request before it can be reused for a different
+// call.
+func WithIdempotencyKeyExpiration(d time.Duration) RequestOption {
+	return requestOptionFunc(func(c *requestConfig) {
+		c.idempotencyKeyExpiration = d
+	})
+}
+
+// WithAutoIdempotencyKey generates a random UUIDv4 idempotency key for this
+// request, unless one has already been set via WithIdempotencyKey.
+func WithAutoIdempotencyKey() RequestOption {
+	return requestOptionFunc(func(c *requestConfig) {
+		c.autoIdempotencyKey = true
+	})
+}
+
+// WithBulkChunkSize overrides how many records a bulk ContactsClient call
+// (BulkCreate, BulkUpdate, BulkDelete) submits per job, splitting larger
+// slices into multiple sequential submissions. Defaults to 100.
+func WithBulkChunkSize(n int) RequestOption {
+	return requestOptionFunc(func(c *requestConfig) {
+		c.bulkChunkSize = n
+	})
+}
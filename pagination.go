@@ -0,0 +1,88 @@
+package freshdesk
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/google/go-querystring/query"
+)
+
+// Page is a single page of items returned by a List call, along with a
+// cursor to the next page. Every subsystem's List method returns a Page so
+// callers can walk results the same way regardless of resource type.
+type Page[T any] struct {
+	Items []T
+	// NextPage is the page number to pass back in to fetch the next page, or
+	// 0 if this was the last page
+	NextPage int
+}
+
+// listPage issues a GET against endpoint, encoding opts as a query string,
+// and decodes the response into a Page[T], reading NextPage off the Link
+// response header. It is the shared implementation behind every subsystem's
+// List method.
+func listPage[T any](ctx context.Context, c *client, endpoint string, opts interface{}, reqOpts ...RequestOption) (*Page[T], error) {
+	if opts != nil {
+		values, err := query.Values(opts)
+		if err != nil {
+			return nil, err
+		}
+		if encoded := values.Encode(); encoded != "" {
+			endpoint += "?" + encoded
+		}
+	}
+
+	req, err := c.newRequest(ctx, http.MethodGet, endpoint, nil, reqOpts...)
+	if err != nil {
+		return nil, err
+	}
+
+	var items []T
+	res, err := c.doWithResponse(req, &items, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Page[T]{
+		Items:    items,
+		NextPage: parseNextPage(res.Header.Get("Link")),
+	}, nil
+}
+
+// parseNextPage extracts the `page` query parameter from the `rel="next"`
+// entry of a Link header, as returned by Freshdesk's list endpoints. It
+// returns 0 if there is no next page.
+func parseNextPage(linkHeader string) int {
+	for _, link := range strings.Split(linkHeader, ",") {
+		parts := strings.Split(link, ";")
+		if len(parts) < 2 {
+			continue
+		}
+
+		rel := strings.TrimSpace(parts[1])
+		if rel != `rel="next"` {
+			continue
+		}
+
+		rawURL := strings.TrimSpace(parts[0])
+		rawURL = strings.TrimPrefix(rawURL, "<")
+		rawURL = strings.TrimSuffix(rawURL, ">")
+
+		u, err := url.Parse(rawURL)
+		if err != nil {
+			continue
+		}
+
+		page, err := strconv.Atoi(u.Query().Get("page"))
+		if err != nil {
+			continue
+		}
+
+		return page
+	}
+
+	return 0
+}
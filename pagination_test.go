@@ -0,0 +1,50 @@
+package freshdesk
+
+import "testing"
+
+func TestParseNextPage(t *testing.T) {
+	tests := []struct {
+		name       string
+		linkHeader string
+		want       int
+	}{
+		{
+			name:       "no link header",
+			linkHeader: "",
+			want:       0,
+		},
+		{
+			name:       "next page present",
+			linkHeader: `<https://example.freshdesk.com/api/v2/contacts?page=2>; rel="next"`,
+			want:       2,
+		},
+		{
+			name:       "prev and next both present",
+			linkHeader: `<https://example.freshdesk.com/api/v2/contacts?page=1>; rel="prev", <https://example.freshdesk.com/api/v2/contacts?page=3>; rel="next"`,
+			want:       3,
+		},
+		{
+			name:       "only prev present",
+			linkHeader: `<https://example.freshdesk.com/api/v2/contacts?page=1>; rel="prev"`,
+			want:       0,
+		},
+		{
+			name:       "malformed link header",
+			linkHeader: "not a link header",
+			want:       0,
+		},
+		{
+			name:       "next link missing page query param",
+			linkHeader: `<https://example.freshdesk.com/api/v2/contacts>; rel="next"`,
+			want:       0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := parseNextPage(tt.linkHeader); got != tt.want {
+				t.Errorf("parseNextPage(%q) = %d, want %d", tt.linkHeader, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package freshdesk
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+// RateLimitStatus is a snapshot of the Freshdesk API rate-limit headers as of
+// the most recently completed request.
+type RateLimitStatus struct {
+	// Limit is the total number of requests allowed in the current window
+	Limit int
+	// Remaining is the number of requests left in the current window
+	Remaining int
+	// Used is the number of requests consumed by the request that produced this snapshot
+	Used int
+	// RemainingKnown is true if the response carried an X-RateLimit-Remaining
+	// header, distinguishing a quota of 0 from the header being absent
+	RemainingKnown bool
+	// RetryAfter is how long to wait before retrying, populated from the
+	// Retry-After header on 429 responses
+	RetryAfter time.Duration
+}
+
+func parseRateLimitStatus(h http.Header) RateLimitStatus {
+	status := RateLimitStatus{
+		Limit: atoiOrZero(h.Get("X-RateLimit-Total")),
+		Used:  atoiOrZero(h.Get("X-RateLimit-Used-CurrentRequest")),
+	}
+	if remaining := h.Get("X-RateLimit-Remaining"); remaining != "" {
+		status.Remaining = atoiOrZero(remaining)
+		status.RemainingKnown = true
+	}
+	if ra := h.Get("Retry-After"); ra != "" {
+		status.RetryAfter = parseRetryAfter(ra)
+	}
+	return status
+}
+
+// parseRetryAfter parses a Retry-After header, which Freshdesk sends as
+// either a number of seconds or an HTTP-date.
+func parseRetryAfter(v string) time.Duration {
+	if secs, err := strconv.Atoi(v); err == nil {
+		return time.Duration(secs) * time.Second
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		if d := time.Until(t); d > 0 {
+			return d
+		}
+	}
+	return 0
+}
+
+func atoiOrZero(s string) int {
+	n, _ := strconv.Atoi(s)
+	return n
+}
+
+const defaultRateLimitThreshold = 5
+
+// rateLimitCheckRetry wraps retryablehttp's default retry policy to also
+// retry on 429 Too Many Requests, which Freshdesk uses for rate limiting.
+func rateLimitCheckRetry(ctx context.Context, resp *http.Response, err error) (bool, error) {
+	if resp != nil && resp.StatusCode == http.StatusTooManyRequests {
+		return true, nil
+	}
+	return retryablehttp.DefaultRetryPolicy(ctx, resp, err)
+}
+
+// newRateLimitBackoff returns a retryablehttp.Backoff that honors the
+// Retry-After header on 429 responses and otherwise falls back to the
+// default exponential backoff, extended when the remaining quota reported by
+// Freshdesk falls below threshold.
+func newRateLimitBackoff(threshold int) retryablehttp.Backoff {
+	return func(minWait, maxWait time.Duration, attemptNum int, resp *http.Response) time.Duration {
+		if resp != nil {
+			status := parseRateLimitStatus(resp.Header)
+			if resp.StatusCode == http.StatusTooManyRequests {
+				if status.RetryAfter > 0 {
+					return status.RetryAfter
+				}
+				return maxWait
+			}
+			if status.RemainingKnown && status.Remaining < threshold {
+				return maxWait
+			}
+		}
+		return retryablehttp.DefaultBackoff(minWait, maxWait, attemptNum, resp)
+	}
+}
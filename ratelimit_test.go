@@ -0,0 +1,113 @@
+package freshdesk
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+func TestParseRateLimitStatus(t *testing.T) {
+	t.Run("remaining header present", func(t *testing.T) {
+		h := http.Header{}
+		h.Set("X-RateLimit-Total", "100")
+		h.Set("X-RateLimit-Remaining", "0")
+		h.Set("X-RateLimit-Used-CurrentRequest", "1")
+
+		status := parseRateLimitStatus(h)
+		if !status.RemainingKnown {
+			t.Fatal("expected RemainingKnown to be true when the header is present")
+		}
+		if status.Remaining != 0 {
+			t.Errorf("Remaining = %d, want 0", status.Remaining)
+		}
+	})
+
+	t.Run("remaining header absent", func(t *testing.T) {
+		status := parseRateLimitStatus(http.Header{})
+		if status.RemainingKnown {
+			t.Fatal("expected RemainingKnown to be false when the header is absent")
+		}
+	})
+}
+
+func TestNewRateLimitBackoff(t *testing.T) {
+	const threshold = 5
+	minWait, maxWait := time.Second, 30*time.Second
+	backoff := newRateLimitBackoff(threshold)
+
+	tests := []struct {
+		name string
+		resp *http.Response
+		want time.Duration
+	}{
+		{
+			name: "no response falls back to default backoff",
+			resp: nil,
+			want: retryDefaultBackoff(minWait, maxWait, 1, nil),
+		},
+		{
+			name: "429 with Retry-After seconds honors it exactly",
+			resp: responseWithHeaders(http.StatusTooManyRequests, map[string]string{
+				"Retry-After": "7",
+			}),
+			want: 7 * time.Second,
+		},
+		{
+			name: "429 with no Retry-After and no remaining header still backs off fully",
+			resp: responseWithHeaders(http.StatusTooManyRequests, nil),
+			want: maxWait,
+		},
+		{
+			name: "429 with X-RateLimit-Remaining: 0 and no Retry-After backs off fully",
+			resp: responseWithHeaders(http.StatusTooManyRequests, map[string]string{
+				"X-RateLimit-Remaining": "0",
+			}),
+			want: maxWait,
+		},
+		{
+			name: "200 with remaining below threshold backs off fully",
+			resp: responseWithHeaders(http.StatusOK, map[string]string{
+				"X-RateLimit-Remaining": "3",
+			}),
+			want: maxWait,
+		},
+		{
+			name: "200 with remaining comfortably above threshold uses default backoff",
+			resp: responseWithHeaders(http.StatusOK, map[string]string{
+				"X-RateLimit-Remaining": "50",
+			}),
+			want: retryDefaultBackoff(minWait, maxWait, 1, responseWithHeaders(http.StatusOK, map[string]string{
+				"X-RateLimit-Remaining": "50",
+			})),
+		},
+		{
+			name: "200 with no rate-limit headers at all uses default backoff",
+			resp: responseWithHeaders(http.StatusOK, nil),
+			want: retryDefaultBackoff(minWait, maxWait, 1, responseWithHeaders(http.StatusOK, nil)),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := backoff(minWait, maxWait, 1, tt.resp); got != tt.want {
+				t.Errorf("backoff() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func responseWithHeaders(status int, headers map[string]string) *http.Response {
+	h := http.Header{}
+	for k, v := range headers {
+		h.Set(k, v)
+	}
+	return &http.Response{StatusCode: status, Header: h}
+}
+
+// retryDefaultBackoff just calls retryablehttp.DefaultBackoff so the
+// "falls through to the default" cases don't hardcode its exact curve.
+func retryDefaultBackoff(minWait, maxWait time.Duration, attemptNum int, resp *http.Response) time.Duration {
+	return retryablehttp.DefaultBackoff(minWait, maxWait, attemptNum, resp)
+}
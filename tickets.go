@@ -0,0 +1,170 @@
+package freshdesk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// TicketStatus is the lifecycle state of a ticket.
+type TicketStatus int
+
+const (
+	TicketStatusOpen     TicketStatus = 2
+	TicketStatusPending  TicketStatus = 3
+	TicketStatusResolved TicketStatus = 4
+	TicketStatusClosed   TicketStatus = 5
+)
+
+// TicketPriority is how urgently a ticket needs to be handled.
+type TicketPriority int
+
+const (
+	TicketPriorityLow    TicketPriority = 1
+	TicketPriorityMedium TicketPriority = 2
+	TicketPriorityHigh   TicketPriority = 3
+	TicketPriorityUrgent TicketPriority = 4
+)
+
+type Ticket struct {
+	// ID of the ticket
+	ID uint64 `json:"id,omitempty"`
+	// Subject of the ticket
+	Subject string `json:"subject"`
+	// HTML content of the ticket
+	Description string `json:"description,omitempty"`
+	// Status of the ticket
+	Status TicketStatus `json:"status,omitempty"`
+	// Priority of the ticket
+	Priority TicketPriority `json:"priority,omitempty"`
+	// The channel through which the ticket was created
+	Source int `json:"source,omitempty"`
+	// Email address of the requester
+	Email string `json:"email,omitempty"`
+	// User ID of the requester
+	RequesterID uint64 `json:"requester_id,omitempty"`
+	// ID of the agent the ticket is assigned to
+	ResponderID *uint64 `json:"responder_id,omitempty"`
+	// ID of the group the ticket is assigned to
+	GroupID *uint64 `json:"group_id,omitempty"`
+	// ID of the company the requester belongs to
+	CompanyID *uint64 `json:"company_id,omitempty"`
+	// Tags associated with this ticket
+	Tags []string `json:"tags,omitempty"`
+	// Timestamp at which the ticket is due to be resolved
+	DueBy *time.Time `json:"due_by,omitempty"`
+	// Ticket creation timestamp
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	// Ticket updated timestamp
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+	// Key value pair containing the name and value of the custom fields
+	CustomFields map[string]interface{} `json:"custom_fields,omitempty"`
+}
+
+// TicketListOptions controls pagination and filtering for TicketsClient.List.
+type TicketListOptions struct {
+	// Page number to fetch, starting at 1
+	Page int `url:"page,omitempty"`
+	// Number of tickets per page (max 100)
+	PerPage int `url:"per_page,omitempty"`
+	// Only return tickets updated at or after this time
+	UpdatedSince *time.Time `url:"updated_since,omitempty"`
+	// Filter by the ID of the requester
+	RequesterID uint64 `url:"requester_id,omitempty"`
+	// Filter by the email address of the requester
+	Email string `url:"email,omitempty"`
+	// Filter by the ID of the company the requester belongs to
+	CompanyID uint64 `url:"company_id,omitempty"`
+}
+
+type TicketPage = Page[*Ticket]
+
+type TicketsClient interface {
+	Create(ctx context.Context, t *Ticket, opts ...RequestOption) (*Ticket, error)
+	Update(ctx context.Context, id uint64, t *Ticket, opts ...RequestOption) (*Ticket, error)
+	View(ctx context.Context, id uint64, opts ...RequestOption) (*Ticket, error)
+	List(ctx context.Context, opts *TicketListOptions, reqOpts ...RequestOption) (*TicketPage, error)
+	Delete(ctx context.Context, id uint64, opts ...RequestOption) error
+	// UpdateStatus transitions a ticket to a new status, e.g. resolving or closing it
+	UpdateStatus(ctx context.Context, id uint64, status TicketStatus, opts ...RequestOption) (*Ticket, error)
+}
+
+type ticketsClient struct {
+	*client
+}
+
+// Create creates a new ticket
+func (c *ticketsClient) Create(ctx context.Context, t *Ticket, opts ...RequestOption) (*Ticket, error) {
+	req, err := c.client.newRequest(ctx, http.MethodPost, "tickets", t, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	res := new(Ticket)
+	err = c.client.do(req, res, http.StatusCreated)
+
+	return res, err
+}
+
+// Update updates an existing ticket
+func (c *ticketsClient) Update(ctx context.Context, id uint64, t *Ticket, opts ...RequestOption) (*Ticket, error) {
+	req, err := c.client.newRequest(ctx, http.MethodPut, fmt.Sprintf("tickets/%d", id), t, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	res := new(Ticket)
+	err = c.client.do(req, res, http.StatusOK)
+
+	return res, err
+}
+
+// View gets an existing ticket by id
+func (c *ticketsClient) View(ctx context.Context, id uint64, opts ...RequestOption) (*Ticket, error) {
+	req, err := c.client.newRequest(ctx, http.MethodGet, fmt.Sprintf("tickets/%d", id), nil, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	res := new(Ticket)
+	err = c.client.do(req, res, http.StatusOK)
+
+	return res, err
+}
+
+// List fetches a single page of tickets, optionally filtered via opts
+func (c *ticketsClient) List(ctx context.Context, opts *TicketListOptions, reqOpts ...RequestOption) (*TicketPage, error) {
+	if opts == nil {
+		opts = &TicketListOptions{}
+	}
+
+	return listPage[*Ticket](ctx, c.client, "tickets", opts, reqOpts...)
+}
+
+// Delete deletes an existing ticket
+func (c *ticketsClient) Delete(ctx context.Context, id uint64, opts ...RequestOption) error {
+	req, err := c.client.newRequest(ctx, http.MethodDelete, fmt.Sprintf("tickets/%d", id), nil, opts...)
+	if err != nil {
+		return err
+	}
+
+	return c.client.do(req, nil, http.StatusNoContent)
+}
+
+// UpdateStatus transitions a ticket to a new status, e.g. resolving or closing it
+func (c *ticketsClient) UpdateStatus(ctx context.Context, id uint64, status TicketStatus, opts ...RequestOption) (*Ticket, error) {
+	type params struct {
+		Status TicketStatus `json:"status"`
+	}
+
+	req, err := c.client.newRequest(ctx, http.MethodPut, fmt.Sprintf("tickets/%d", id), &params{Status: status}, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	res := new(Ticket)
+	err = c.client.do(req, res, http.StatusOK)
+
+	return res, err
+}